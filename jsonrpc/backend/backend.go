@@ -0,0 +1,148 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/initia-labs/minievm/app"
+	"github.com/initia-labs/minievm/jsonrpc/config"
+	"github.com/initia-labs/minievm/jsonrpc/indexer"
+)
+
+// scanBlocksForTxHashWindow bounds how many blocks scanBlocksForTxHash will
+// walk when it falls back to a live RPC scan, so a miss on a very old hash
+// can't turn into an unbounded scan of the whole chain.
+const scanBlocksForTxHashWindow = 128
+
+// JSONRPCBackend implements the data access methods the eth/net/filters
+// namespaces need, translating between Cosmos SDK/CometBFT primitives and
+// their Ethereum JSON-RPC equivalents.
+type JSONRPCBackend struct {
+	logger    log.Logger
+	app       *app.MinitiaApp
+	svrCtx    *server.Context
+	clientCtx client.Context
+	config    config.JSONRPCConfig
+
+	// indexer is consulted first for hash lookups; it may be nil if
+	// indexing is disabled, in which case callers fall back to an RPC
+	// block scan.
+	indexer *indexer.EVMTxIndexer
+}
+
+// NewJSONRPCBackend constructs a JSONRPCBackend. The indexer may be nil.
+func NewJSONRPCBackend(
+	app *app.MinitiaApp,
+	svrCtx *server.Context,
+	clientCtx client.Context,
+	jsonRPCConfig config.JSONRPCConfig,
+	idx *indexer.EVMTxIndexer,
+) *JSONRPCBackend {
+	return &JSONRPCBackend{
+		logger:    svrCtx.Logger.With("module", "jsonrpc-backend"),
+		app:       app,
+		svrCtx:    svrCtx,
+		clientCtx: clientCtx,
+		config:    jsonRPCConfig,
+		indexer:   idx,
+	}
+}
+
+// GetTransactionByHash resolves an Ethereum transaction by hash. It
+// consults the EVMTxIndexer first and only falls back to scanning Cosmos
+// blocks over RPC when the indexer is nil or has not yet caught up to the
+// chain tip.
+func (b *JSONRPCBackend) GetTransactionByHash(ctx context.Context, hash common.Hash) (*ethtypes.Transaction, error) {
+	if b.indexer != nil {
+		res, err := b.indexer.GetByTxHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		if res != nil {
+			return b.txFromIndexResult(ctx, res)
+		}
+
+		tip, err := b.LatestHeight(ctx)
+		if err == nil {
+			last, lastErr := b.indexer.LastIndexedBlock()
+			if lastErr == nil && last >= tip {
+				// the indexer is caught up and simply has no record of this
+				// hash, so there is no point falling back to a full scan.
+				return nil, nil
+			}
+		}
+		b.logger.Debug("indexer miss while behind chain tip, falling back to RPC scan", "hash", hash)
+	}
+
+	return b.scanBlocksForTxHash(ctx, hash)
+}
+
+// txFromIndexResult re-decodes the Ethereum transaction an indexed TxResult
+// points at, by re-fetching its block and picking out the tx at TxIndex.
+func (b *JSONRPCBackend) txFromIndexResult(ctx context.Context, res *indexer.TxResult) (*ethtypes.Transaction, error) {
+	height := res.BlockHeight
+	block, err := b.clientCtx.Client.Block(ctx, &height)
+	if err != nil {
+		return nil, fmt.Errorf("backend: failed to fetch block %d for indexed tx: %w", height, err)
+	}
+	if int(res.TxIndex) >= len(block.Block.Txs) {
+		return nil, fmt.Errorf("backend: indexed tx index %d out of range for block %d", res.TxIndex, height)
+	}
+
+	return b.DecodeEthTx(block.Block.Txs[res.TxIndex])
+}
+
+// scanBlocksForTxHash is the fallback used when the indexer is nil or still
+// behind the chain tip: it walks backwards from the current tip looking for
+// the given hash, bounded by scanBlocksForTxHashWindow so a miss can't turn
+// into an unbounded scan.
+func (b *JSONRPCBackend) scanBlocksForTxHash(ctx context.Context, hash common.Hash) (*ethtypes.Transaction, error) {
+	tip, err := b.LatestHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	from := tip - scanBlocksForTxHashWindow + 1
+	if from < 1 {
+		from = 1
+	}
+
+	for height := tip; height >= from; height-- {
+		results, err := b.ScanBlock(ctx, height)
+		if err != nil {
+			b.logger.Error("failed to scan block while looking up tx hash", "height", height, "err", err)
+			continue
+		}
+		for _, res := range results {
+			if res.EthHash == hash {
+				return b.txFromIndexResult(ctx, &res)
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// LatestHeight returns the height of the latest committed block, used by
+// the indexer's catch-up loop to know when it has reached the chain tip.
+func (b *JSONRPCBackend) LatestHeight(ctx context.Context) (int64, error) {
+	status, err := b.clientCtx.Client.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// ScanBlock implements indexer.BlockSource by decoding the first
+// MsgEthereumTx-equivalent message of every tx in the block at the given
+// height.
+func (b *JSONRPCBackend) ScanBlock(ctx context.Context, height int64) ([]indexer.TxResult, error) {
+	return indexer.ScanBlockTxs(ctx, b.clientCtx.Client, b.clientCtx.TxConfig.TxDecoder(), height)
+}
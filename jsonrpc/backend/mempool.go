@@ -0,0 +1,55 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	comettypes "github.com/cometbft/cometbft/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/minievm/jsonrpc/evmtx"
+)
+
+// DecodeEthTx decodes a raw CometBFT mempool tx (a wrapped Cosmos tx
+// carrying a MsgEthereumTx-equivalent message) into its underlying Ethereum
+// transaction. It returns an error for any tx that isn't an EVM tx, which
+// callers should treat as "skip this entry" rather than a hard failure.
+//
+// This is the same decoder used when scanning committed blocks, so mempool
+// and block-scan consumers stay consistent.
+func (b *JSONRPCBackend) DecodeEthTx(tx comettypes.Tx) (*ethtypes.Transaction, error) {
+	return evmtx.Decode(b.clientCtx.TxConfig.TxDecoder(), tx)
+}
+
+// Nonce returns the given address's current (committed) account nonce, used
+// to tell pending mempool txs apart from queued ones.
+func (b *JSONRPCBackend) Nonce(ctx context.Context, address common.Address) (uint64, error) {
+	queryClient := authtypes.NewQueryClient(b.clientCtx)
+	res, err := queryClient.Account(ctx, &authtypes.QueryAccountRequest{
+		Address: sdk.AccAddress(address.Bytes()).String(),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			// An address with no on-chain account yet has never sent a tx,
+			// so its next valid nonce is 0, matching geth's semantics.
+			return 0, nil
+		}
+		return 0, fmt.Errorf("backend: failed to query account %s: %w", address, err)
+	}
+
+	var account sdk.AccountI
+	if err := b.clientCtx.InterfaceRegistry.UnpackAny(res.Account, &account); err != nil {
+		return 0, fmt.Errorf("backend: failed to unpack account %s: %w", address, err)
+	}
+
+	return account.GetSequence(), nil
+}
@@ -0,0 +1,44 @@
+package backend
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// errUnprotectedTx is returned for replay-unprotected (non-EIP155) txs
+// unless the node was started with AllowUnprotectedTxs.
+var errUnprotectedTx = errors.New("rejected transaction with replay protection disabled, set allow-unprotected-txs to accept it")
+
+// errBroadcastNotWired explains precisely what's missing to finish
+// SendRawTransaction once a tx has decoded and passed the EIP155 check:
+// broadcasting requires wrapping the decoded ethtypes.Transaction in the
+// x/evm module's Cosmos message type and submitting it through
+// clientCtx.BroadcastTx, and this backend has no x/evm message type to wrap
+// it with.
+var errBroadcastNotWired = errors.New("backend: broadcasting requires wrapping the transaction in the x/evm module's Cosmos message type, which this backend does not have access to")
+
+// SendRawTransaction decodes a signed Ethereum transaction and rejects it
+// outright if it fails to decode or, absent JSONRPCConfig.AllowUnprotectedTxs,
+// isn't EIP155-protected, matching geth/ethermint's default refusal to relay
+// replay-unprotected txs. It does not yet broadcast the transaction; see
+// errBroadcastNotWired.
+func (b *JSONRPCBackend) SendRawTransaction(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+	tx := new(ethtypes.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, err
+	}
+
+	if !b.config.AllowUnprotectedTxs && !tx.Protected() {
+		return common.Hash{}, errUnprotectedTx
+	}
+
+	return b.broadcastTx(ctx, tx)
+}
+
+func (b *JSONRPCBackend) broadcastTx(ctx context.Context, tx *ethtypes.Transaction) (common.Hash, error) {
+	return common.Hash{}, errBroadcastNotWired
+}
@@ -0,0 +1,82 @@
+package cometws
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"cosmossdk.io/log"
+	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+
+	"github.com/initia-labs/minievm/jsonrpc/config"
+)
+
+// ReconnectNotifier lets independent consumers of a single CometBFT WS
+// connection (the eth filters event broker, the EVM tx indexer, ...)
+// register a callback that runs whenever the connection reconnects, so
+// each can re-establish whatever server-side subscriptions it had before
+// the blip.
+type ReconnectNotifier struct {
+	mu    sync.Mutex
+	hooks []func()
+}
+
+// OnReconnect registers a callback to run on every reconnect.
+func (n *ReconnectNotifier) OnReconnect(fn func()) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.hooks = append(n.hooks, fn)
+}
+
+func (n *ReconnectNotifier) fire() {
+	n.mu.Lock()
+	hooks := make([]func(), len(n.hooks))
+	copy(hooks, n.hooks)
+	n.mu.Unlock()
+
+	for _, hook := range hooks {
+		hook()
+	}
+}
+
+// Connect dials the CometBFT node's WebSocket endpoint using the given
+// reconnect/ping/timeout tunables and returns both the client and a
+// ReconnectNotifier other packages can hook into.
+//
+// reference: https://github.com/evmos/ethermint/blob/fd8c2d25cf80e7d2d2a142e7b374f979f8f51981/server/util.go#L74
+func Connect(addr, endpoint string, logger log.Logger, cfg config.CometWSConfig) (*rpcclient.WSClient, *ReconnectNotifier, error) {
+	notifier := &ReconnectNotifier{}
+
+	client, err := rpcclient.NewWS(addr, endpoint,
+		rpcclient.MaxReconnectAttempts(cfg.MaxReconnectAttempts),
+		rpcclient.ReadWait(cfg.ReadWait),
+		// If readWait is not zero, pingPeriod must be less than readWait to avoid abnormal closure.
+		// https://github.com/initia-labs/cometbft/blob/6c77a401128cb7dd8368ba8fbe7f30caf4fffa96/rpc/jsonrpc/client/ws_client.go#L77
+		rpcclient.WriteWait(cfg.WriteWait),
+		rpcclient.PingPeriod(cfg.PingPeriod),
+		rpcclient.OnReconnect(func() {
+			logger.Debug("EVM RPC reconnects to Comet WS", "address", addr+endpoint)
+			notifier.fire()
+		}),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("comet WS client could not be created: %w", err)
+	}
+
+	if err := client.OnStart(); err != nil {
+		return nil, nil, fmt.Errorf("comet WS client could not start: %w", err)
+	}
+
+	return client, notifier, nil
+}
+
+// NormalizeRPCListenAddress converts a CometBFT config.toml RPC.ListenAddress
+// (e.g. "tcp://127.0.0.1:26657") into an address Connect/rpcclient.NewWS
+// can dial. unix:// addresses are passed through unchanged since the WS
+// client supports them directly.
+func NormalizeRPCListenAddress(listenAddr string) string {
+	if rest, ok := strings.CutPrefix(listenAddr, "tcp://"); ok {
+		return "http://" + rest
+	}
+	return listenAddr
+}
@@ -0,0 +1,133 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// JSONRPCConfig defines configuration for the EVM JSON-RPC server.
+type JSONRPCConfig struct {
+	// Enable defines if the EVM JSON-RPC server should be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// Address defines the HTTP server to listen on for JSON-RPC requests.
+	Address string `mapstructure:"address"`
+
+	// WSAddress defines the WebSocket server to listen on for JSON-RPC
+	// requests and subscriptions (eth_subscribe / eth_unsubscribe).
+	WSAddress string `mapstructure:"ws-address"`
+
+	// EnableWS toggles the WebSocket JSON-RPC server.
+	EnableWS bool `mapstructure:"enable-ws"`
+
+	// EnableUnsafeCORS enables CORS for all origins on the HTTP JSON-RPC
+	// server. It must not be used in production deployments.
+	EnableUnsafeCORS bool `mapstructure:"enable-unsafe-cors"`
+
+	// HTTPTimeout is the read/write timeout applied to the HTTP JSON-RPC
+	// server.
+	HTTPTimeout time.Duration `mapstructure:"http-timeout"`
+
+	// HTTPIdleTimeout is the idle timeout applied to the HTTP JSON-RPC
+	// server.
+	HTTPIdleTimeout time.Duration `mapstructure:"http-idle-timeout"`
+
+	// MaxOpenConnections caps the number of simultaneous connections
+	// accepted by the HTTP JSON-RPC server. Zero means unlimited.
+	MaxOpenConnections int `mapstructure:"max-open-connections"`
+
+	// API defines the list of JSON-RPC namespaces to register, e.g.
+	// ["eth", "net", "web3", "txpool"]. Listing an unknown namespace, or one
+	// that is recognized but not implemented (debug, personal, miner), is a
+	// startup error.
+	API []string `mapstructure:"api"`
+
+	// AllowUnprotectedTxs allows eth_sendRawTransaction to accept
+	// non-EIP155 (replay-unprotected) transactions. It should stay false in
+	// production deployments.
+	AllowUnprotectedTxs bool `mapstructure:"allow-unprotected-txs"`
+
+	// EnableDebug is reserved for the debug namespace (debug_traceTransaction,
+	// debug_traceCall, debug_traceBlockBy*, debug_storageRangeAt). Listing
+	// "debug" in API is currently always a startup error: tracing requires
+	// replaying historical EVM state through the x/evm keeper, which this
+	// backend does not yet do.
+	EnableDebug bool `mapstructure:"enable-debug"`
+
+	// TraceTimeout will bound how long a single debug_trace* request may run,
+	// once tracing is implemented. Unused until then.
+	TraceTimeout time.Duration `mapstructure:"trace-timeout"`
+
+	// TraceGasCap will cap the gas a single debug_trace* replay may consume,
+	// once tracing is implemented. Unused until then.
+	TraceGasCap uint64 `mapstructure:"trace-gas-cap"`
+
+	// CometWS holds the reconnect/ping/timeout tunables for the client used
+	// to subscribe to the local CometBFT node's event stream.
+	CometWS CometWSConfig `mapstructure:"comet-ws"`
+}
+
+// CometWSConfig configures the WS client JSON-RPC uses to subscribe to the
+// local CometBFT node's NewBlock/Tx events (e.g. for eth_subscribe and the
+// EVM tx indexer).
+type CometWSConfig struct {
+	// MaxReconnectAttempts caps how many times the client retries dialing
+	// the CometBFT WS endpoint before giving up.
+	MaxReconnectAttempts int `mapstructure:"max-reconnect-attempts"`
+
+	// ReadWait is the read deadline applied to the WS connection. Zero
+	// disables the deadline.
+	ReadWait time.Duration `mapstructure:"read-wait"`
+
+	// WriteWait is the write deadline applied to the WS connection.
+	WriteWait time.Duration `mapstructure:"write-wait"`
+
+	// PingPeriod is how often the client pings the server to keep the
+	// connection alive. If ReadWait is non-zero, PingPeriod must be less
+	// than ReadWait to avoid the server closing the connection as idle.
+	PingPeriod time.Duration `mapstructure:"ping-period"`
+}
+
+// APIToml renders API as a quoted, comma-separated TOML array literal, e.g.
+// ["eth", "net", "web3"]. Go's default %v formatting of a []string (what
+// {{ .JSONRPC.API }} would otherwise produce) is not valid TOML, so the
+// config template calls this instead of handing the raw slice to the
+// template.
+func (c JSONRPCConfig) APIToml() string {
+	quoted := make([]string, len(c.API))
+	for i, api := range c.API {
+		quoted[i] = fmt.Sprintf("%q", api)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// DefaultConfig returns the default JSON-RPC configuration.
+func DefaultConfig() JSONRPCConfig {
+	return JSONRPCConfig{
+		Enable:              true,
+		Address:             "0.0.0.0:8545",
+		WSAddress:           "0.0.0.0:8546",
+		EnableWS:            true,
+		EnableUnsafeCORS:    false,
+		HTTPTimeout:         30 * time.Second,
+		HTTPIdleTimeout:     120 * time.Second,
+		MaxOpenConnections:  0,
+		API:                 []string{"eth", "net", "web3"},
+		AllowUnprotectedTxs: false,
+		EnableDebug:         false,
+		TraceTimeout:        30 * time.Second,
+		TraceGasCap:         50_000_000,
+		CometWS:             DefaultCometWSConfig(),
+	}
+}
+
+// DefaultCometWSConfig returns the default CometBFT WS client tunables.
+func DefaultCometWSConfig() CometWSConfig {
+	return CometWSConfig{
+		MaxReconnectAttempts: 256,
+		ReadWait:             0,
+		WriteWait:            0,
+		PingPeriod:           50 * time.Second,
+	}
+}
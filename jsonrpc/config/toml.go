@@ -0,0 +1,85 @@
+package config
+
+// ConfigTemplate is the [json-rpc] section appended to app.toml, mirroring
+// the field names/defaults in JSONRPCConfig/CometWSConfig. It is meant to be
+// concatenated onto the root command's app.toml template (alongside the
+// Cosmos SDK's own sections) the same way other modules contribute their
+// own config sections; it is not wired into a root command in this package
+// since minievm's cmd/root.go lives outside the jsonrpc module tree.
+const ConfigTemplate = `
+###############################################################################
+###                             JSON-RPC Configuration                     ###
+###############################################################################
+
+[json-rpc]
+
+# Enable defines if the EVM JSON-RPC server should be enabled.
+enable = {{ .JSONRPC.Enable }}
+
+# Address defines the HTTP server to listen on for JSON-RPC requests.
+address = "{{ .JSONRPC.Address }}"
+
+# WSAddress defines the WebSocket server to listen on for JSON-RPC requests
+# and subscriptions (eth_subscribe / eth_unsubscribe).
+ws-address = "{{ .JSONRPC.WSAddress }}"
+
+# EnableWS toggles the WebSocket JSON-RPC server.
+enable-ws = {{ .JSONRPC.EnableWS }}
+
+# EnableUnsafeCORS enables CORS for all origins on the HTTP JSON-RPC server.
+# It must not be used in production deployments.
+enable-unsafe-cors = {{ .JSONRPC.EnableUnsafeCORS }}
+
+# HTTPTimeout is the read/write timeout applied to the HTTP JSON-RPC server.
+http-timeout = "{{ .JSONRPC.HTTPTimeout }}"
+
+# HTTPIdleTimeout is the idle timeout applied to the HTTP JSON-RPC server.
+http-idle-timeout = "{{ .JSONRPC.HTTPIdleTimeout }}"
+
+# MaxOpenConnections caps the number of simultaneous connections accepted by
+# the HTTP JSON-RPC server. Zero means unlimited.
+max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
+
+# API defines the list of JSON-RPC namespaces to register, e.g.
+# ["eth", "net", "web3", "txpool"]. Listing an unknown namespace, or one
+# that is recognized but not implemented (debug, personal, miner), is a
+# startup error.
+api = {{ .JSONRPC.APIToml }}
+
+# AllowUnprotectedTxs allows eth_sendRawTransaction to accept non-EIP155
+# (replay-unprotected) transactions. It should stay false in production
+# deployments.
+allow-unprotected-txs = {{ .JSONRPC.AllowUnprotectedTxs }}
+
+# EnableDebug is reserved for the debug namespace (debug_traceTransaction,
+# debug_traceCall, debug_traceBlockBy*, debug_storageRangeAt). Listing
+# "debug" in api is currently always a startup error: tracing is not
+# implemented by this backend yet.
+enable-debug = {{ .JSONRPC.EnableDebug }}
+
+# TraceTimeout will bound how long a single debug_trace* request may run,
+# once tracing is implemented. Unused until then.
+trace-timeout = "{{ .JSONRPC.TraceTimeout }}"
+
+# TraceGasCap will cap the gas a single debug_trace* replay may consume,
+# once tracing is implemented. Unused until then.
+trace-gas-cap = {{ .JSONRPC.TraceGasCap }}
+
+[json-rpc.comet-ws]
+
+# MaxReconnectAttempts caps how many times the client retries dialing the
+# CometBFT WS endpoint before giving up.
+max-reconnect-attempts = {{ .JSONRPC.CometWS.MaxReconnectAttempts }}
+
+# ReadWait is the read deadline applied to the WS connection. Zero disables
+# the deadline.
+read-wait = "{{ .JSONRPC.CometWS.ReadWait }}"
+
+# WriteWait is the write deadline applied to the WS connection.
+write-wait = "{{ .JSONRPC.CometWS.WriteWait }}"
+
+# PingPeriod is how often the client pings the server to keep the connection
+# alive. If read-wait is non-zero, ping-period must be less than read-wait
+# to avoid the server closing the connection as idle.
+ping-period = "{{ .JSONRPC.CometWS.PingPeriod }}"
+`
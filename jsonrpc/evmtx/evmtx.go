@@ -0,0 +1,58 @@
+// Package evmtx decodes the Cosmos transactions carried over CometBFT
+// (mempool entries, committed block txs) back into their underlying
+// go-ethereum transaction, so the JSON-RPC backend, the EVM tx indexer and
+// the eth filters event broker all agree on what "the Ethereum transaction
+// inside this Cosmos tx" means.
+package evmtx
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ErrNoEthMessage is returned by Decode when a Cosmos tx does not carry an
+// Ethereum message, e.g. a plain bank-send or staking tx. Callers should
+// treat it as "skip this entry", not a hard failure.
+var ErrNoEthMessage = errors.New("evmtx: tx does not contain an Ethereum message")
+
+// EthMessage is implemented by x/evm's MsgEthereumTx. It is declared locally
+// rather than imported so this package has no dependency on the EVM module.
+type EthMessage interface {
+	AsTransaction() (*ethtypes.Transaction, error)
+}
+
+// Decode decodes a raw Cosmos tx with the given decoder and returns the
+// go-ethereum transaction carried by its first EthMessage. Txs with no
+// EthMessage return ErrNoEthMessage.
+func Decode(decoder sdk.TxDecoder, raw []byte) (*ethtypes.Transaction, error) {
+	sdkTx, err := decoder(raw)
+	if err != nil {
+		return nil, fmt.Errorf("evmtx: failed to decode Cosmos tx: %w", err)
+	}
+
+	for _, msg := range sdkTx.GetMsgs() {
+		ethMsg, ok := msg.(EthMessage)
+		if !ok {
+			continue
+		}
+		tx, err := ethMsg.AsTransaction()
+		if err != nil {
+			return nil, fmt.Errorf("evmtx: failed to convert Ethereum message to transaction: %w", err)
+		}
+		return tx, nil
+	}
+
+	return nil, ErrNoEthMessage
+}
+
+// Sender recovers the sending address of an Ethereum transaction using the
+// signer for its chain ID.
+func Sender(tx *ethtypes.Transaction) (common.Address, error) {
+	signer := ethtypes.LatestSignerForChainID(tx.ChainId())
+	return ethtypes.Sender(signer, tx)
+}
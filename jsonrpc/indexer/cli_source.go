@@ -0,0 +1,37 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// cliBlockSource implements BlockSource for the `index-eth-tx` CLI command,
+// using a plain client.Context instead of the JSON-RPC backend's app/keeper
+// wiring.
+type cliBlockSource struct {
+	clientCtx client.Context
+}
+
+func newCLIBlockSource(clientCtx client.Context) (*cliBlockSource, error) {
+	if clientCtx.Client == nil {
+		return nil, errors.New("no RPC client configured, pass --node")
+	}
+	return &cliBlockSource{clientCtx: clientCtx}, nil
+}
+
+func (s *cliBlockSource) LatestHeight(ctx context.Context) (int64, error) {
+	status, err := s.clientCtx.Client.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// ScanBlock decodes the first MsgEthereumTx-equivalent message of every tx
+// in the block at the given height, using the same ScanBlockTxs helper the JSON-RPC
+// backend's BlockSource implementation uses.
+func (s *cliBlockSource) ScanBlock(ctx context.Context, height int64) ([]TxResult, error) {
+	return ScanBlockTxs(ctx, s.clientCtx.Client, s.clientCtx.TxConfig.TxDecoder(), height)
+}
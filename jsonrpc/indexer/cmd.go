@@ -0,0 +1,66 @@
+package indexer
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// IndexEthTxCmd returns the `minievm index-eth-tx <from> <to>` command,
+// which reindexes the EVMTxIndexer store for the given inclusive height
+// range. It is intended to be wired into the root command alongside the
+// other `minievm` subcommands.
+func IndexEthTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index-eth-tx <from> <to>",
+		Short: "Reindex Ethereum transaction hashes for a range of blocks",
+		Long:  "Reindex Ethereum transaction hashes for a range of blocks into the EVM tx indexer's backing store, bypassing the catch-up loop run by the JSON-RPC server on boot.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid from height %q: %w", args[0], err)
+			}
+			to, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid to height %q: %w", args[1], err)
+			}
+			if to < from {
+				return fmt.Errorf("to height %d is less than from height %d", to, from)
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			idx, err := NewEVMTxIndexer(serverCtx.Config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer idx.Close()
+
+			source, err := newCLIBlockSource(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			for height := from; height <= to; height++ {
+				results, err := source.ScanBlock(cmd.Context(), height)
+				if err != nil {
+					return fmt.Errorf("failed to scan block %d: %w", height, err)
+				}
+				if err := idx.IndexBlock(height, results); err != nil {
+					return fmt.Errorf("failed to index block %d: %w", height, err)
+				}
+				serverCtx.Logger.Info("reindexed block", "height", height)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,142 @@
+package indexer
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// keyLastIndexedBlock stores the height of the last block the indexer
+	// has fully processed.
+	keyLastIndexedBlock = []byte("last_indexed_block")
+
+	txHashPrefix   = []byte{0x01} // txHashPrefix | ethHash -> TxResult
+	blockIdxPrefix = []byte{0x02} // blockIdxPrefix | height | txIndex -> TxResult
+)
+
+// TxResult is the value persisted for every indexed Ethereum transaction. It
+// is enough to answer eth_getTransactionByHash/eth_getTransactionReceipt
+// without rescanning the Cosmos block.
+type TxResult struct {
+	EthHash     common.Hash `json:"ethHash"`
+	CosmosHash  [32]byte    `json:"cosmosHash"`
+	BlockHeight int64       `json:"blockHeight"`
+	TxIndex     uint32      `json:"txIndex"`
+	MsgIndex    uint32      `json:"msgIndex"`
+	Failed      bool        `json:"failed"`
+	GasUsed     uint64      `json:"gasUsed"`
+}
+
+// EVMTxIndexer persists ethHash -> TxResult mappings and per-block receipt
+// data so that JSON-RPC hash lookups don't need to scan Cosmos blocks.
+type EVMTxIndexer struct {
+	db dbm.DB
+}
+
+// NewEVMTxIndexer opens (or creates) the indexer's backing store under
+// <homeDir>/data/evmindexer.db.
+func NewEVMTxIndexer(homeDir string) (*EVMTxIndexer, error) {
+	db, err := dbm.NewDB("evmindexer", dbm.GoLevelDBBackend, fmt.Sprintf("%s/data", homeDir))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open EVM tx indexer db: %w", err)
+	}
+	return &EVMTxIndexer{db: db}, nil
+}
+
+// LastIndexedBlock returns the height of the last block fully indexed, or 0
+// if the indexer has not processed any block yet.
+func (idx *EVMTxIndexer) LastIndexedBlock() (int64, error) {
+	bz, err := idx.db.Get(keyLastIndexedBlock)
+	if err != nil {
+		return 0, err
+	}
+	if len(bz) == 0 {
+		return 0, nil
+	}
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+// GetByTxHash looks up a previously indexed transaction by its Ethereum
+// hash.
+func (idx *EVMTxIndexer) GetByTxHash(ethHash common.Hash) (*TxResult, error) {
+	bz, err := idx.db.Get(append(txHashPrefix, ethHash.Bytes()...))
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	var res TxResult
+	if err := json.Unmarshal(bz, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetByBlockAndIndex looks up a previously indexed transaction by its block
+// height and index within the block.
+func (idx *EVMTxIndexer) GetByBlockAndIndex(height int64, txIndex uint32) (*TxResult, error) {
+	bz, err := idx.db.Get(blockIndexKey(height, txIndex))
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	var res TxResult
+	if err := json.Unmarshal(bz, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// IndexBlock persists every tx result in a committed block and advances the
+// last-indexed-block watermark. It is called both by the live subscription
+// loop and by the catch-up/backfill loop.
+func (idx *EVMTxIndexer) IndexBlock(height int64, results []TxResult) error {
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	for _, res := range results {
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(append(txHashPrefix, res.EthHash.Bytes()...), bz); err != nil {
+			return err
+		}
+		if err := batch.Set(blockIndexKey(height, res.TxIndex), bz); err != nil {
+			return err
+		}
+	}
+
+	heightBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBz, uint64(height))
+	if err := batch.Set(keyLastIndexedBlock, heightBz); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+// Close releases the underlying database handle.
+func (idx *EVMTxIndexer) Close() error {
+	return idx.db.Close()
+}
+
+func blockIndexKey(height int64, txIndex uint32) []byte {
+	key := make([]byte, 0, len(blockIdxPrefix)+12)
+	key = append(key, blockIdxPrefix...)
+	heightBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBz, uint64(height))
+	key = append(key, heightBz...)
+	idxBz := make([]byte, 4)
+	binary.BigEndian.PutUint32(idxBz, txIndex)
+	return append(key, idxBz...)
+}
@@ -0,0 +1,61 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	comettypes "github.com/cometbft/cometbft/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/minievm/jsonrpc/evmtx"
+)
+
+// ScanBlockTxs walks every tx in the committed block at the given height and
+// decodes the ones that carry an Ethereum message into TxResults. It is the
+// single decoding path shared by the JSON-RPC backend and the `index-eth-tx`
+// CLI command, so the two can never disagree about what "indexing this
+// block" means.
+func ScanBlockTxs(ctx context.Context, client rpcclient.Client, txDecoder sdk.TxDecoder, height int64) ([]TxResult, error) {
+	block, err := client.Block(ctx, &height)
+	if err != nil {
+		return nil, fmt.Errorf("indexer: failed to fetch block %d: %w", height, err)
+	}
+
+	blockResults, err := client.BlockResults(ctx, &height)
+	if err != nil {
+		return nil, fmt.Errorf("indexer: failed to fetch block results for %d: %w", height, err)
+	}
+
+	var results []TxResult
+
+	for i, rawTx := range block.Block.Txs {
+		ethTx, err := evmtx.Decode(txDecoder, rawTx)
+		if err != nil {
+			continue // not every Cosmos tx carries an Ethereum message.
+		}
+
+		var gasUsed uint64
+		var failed bool
+		if i < len(blockResults.TxsResults) {
+			txResult := blockResults.TxsResults[i]
+			gasUsed = uint64(txResult.GasUsed)
+			failed = txResult.Code != 0
+		}
+
+		var cosmosHash [32]byte
+		copy(cosmosHash[:], comettypes.Tx(rawTx).Hash())
+
+		results = append(results, TxResult{
+			EthHash:     ethTx.Hash(),
+			CosmosHash:  cosmosHash,
+			BlockHeight: height,
+			TxIndex:     uint32(i),
+			Failed:      failed,
+			GasUsed:     gasUsed,
+		})
+	}
+
+	return results, nil
+}
@@ -0,0 +1,217 @@
+package indexer
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/log"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	wsclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/initia-labs/minievm/app"
+)
+
+// newBlockQuery subscribes the live-follow loop to every committed block,
+// mirroring the query the eth/filters package uses for newHeads.
+const newBlockQuery = "tm.event='NewBlock'"
+
+// pollInterval is how often the live-follow loop re-checks the chain tip
+// when it has no WS client to subscribe with (e.g. comet-ws disabled), so
+// the indexer still makes progress instead of sitting idle forever.
+const pollInterval = 5 * time.Second
+
+// maxHeightRetries bounds how many consecutive failed attempts (the first
+// try included) a height gets before the indexer gives up on it and moves
+// on, so a single permanently-failing height can't wedge the indexer forever.
+const maxHeightRetries = 5
+
+// BlockSource scans a single committed Cosmos block and extracts the
+// Ethereum tx results the indexer needs to persist. It is implemented by the
+// backend so the indexer does not need to know about the EVM keeper's
+// internal message/receipt layout.
+type BlockSource interface {
+	LatestHeight(ctx context.Context) (int64, error)
+	ScanBlock(ctx context.Context, height int64) ([]TxResult, error)
+}
+
+// Service runs the EVMTxIndexer as a background Cosmos SDK service: it
+// back-fills from the last indexed height on boot, then follows new blocks
+// as they are committed.
+type Service struct {
+	logger log.Logger
+	idx    *EVMTxIndexer
+	source BlockSource
+}
+
+// NewService constructs an indexer Service that reads committed blocks from
+// source and persists them into idx. The app argument is not used.
+func NewService(logger log.Logger, idx *EVMTxIndexer, app *app.MinitiaApp, source BlockSource) *Service {
+	return &Service{
+		logger: logger.With("module", "evm-tx-indexer"),
+		idx:    idx,
+		source: source,
+	}
+}
+
+// Start launches the catch-up backfill followed by the live follow loop,
+// registering both with the given errgroup so a failure anywhere shuts the
+// node down the same way the JSON-RPC server does. When ws is
+// non-nil, new blocks are indexed as CometBFT commits them via its
+// NewBlock subscription instead of being discovered by polling.
+func (s *Service) Start(ctx context.Context, g *errgroup.Group, _ client.Context, ws *wsclient.WSClient) error {
+	last, err := s.idx.LastIndexedBlock()
+	if err != nil {
+		return err
+	}
+
+	g.Go(func() error {
+		return s.run(ctx, last, ws)
+	})
+
+	return nil
+}
+
+// run backfills from the last indexed height up to the chain tip, then
+// follows new blocks as they're committed: via ws's NewBlock subscription
+// when available, otherwise by polling LatestHeight.
+func (s *Service) run(ctx context.Context, from int64, ws *wsclient.WSClient) error {
+	tip, err := s.source.LatestHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	next, err := s.indexRange(ctx, from+1, tip)
+	if err != nil {
+		return err
+	}
+
+	if ws == nil {
+		return s.pollForNewBlocks(ctx, next)
+	}
+
+	return s.followNewBlocks(ctx, next, ws)
+}
+
+// indexRange indexes every height in [from, to], skipping a height once it
+// has failed maxHeightRetries attempts in a row so one permanently-bad
+// height can't wedge the indexer. It returns the height just past to, or,
+// if ctx is cancelled first, the first height it did not get to.
+func (s *Service) indexRange(ctx context.Context, from, to int64) (int64, error) {
+	height := from
+	retries := 0
+
+	for height <= to {
+		if ctx.Err() != nil {
+			return height, nil
+		}
+
+		err := s.indexHeight(ctx, height)
+		if err != nil {
+			retries++
+			if retries < maxHeightRetries {
+				continue
+			}
+			s.logger.Error("giving up on height after repeated failures, skipping", "height", height, "retries", retries)
+		}
+
+		retries = 0
+		height++
+
+		if height%1000 == 0 {
+			s.logger.Info("EVM tx indexer progress", "height", height, "tip", to)
+		}
+	}
+
+	return height, nil
+}
+
+// indexHeight scans and persists a single height, logging and returning any
+// failure so the caller can decide whether to retry.
+func (s *Service) indexHeight(ctx context.Context, height int64) error {
+	results, err := s.source.ScanBlock(ctx, height)
+	if err != nil {
+		s.logger.Error("failed to scan block for EVM txs", "height", height, "err", err)
+		return err
+	}
+
+	if err := s.idx.IndexBlock(height, results); err != nil {
+		s.logger.Error("failed to persist indexed block", "height", height, "err", err)
+		return err
+	}
+
+	return nil
+}
+
+// pollForNewBlocks indexes every height from next onward by periodically
+// re-checking the chain tip. It's the fallback used when no WS client is
+// available to subscribe to committed blocks with.
+func (s *Service) pollForNewBlocks(ctx context.Context, next int64) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			tip, err := s.source.LatestHeight(ctx)
+			if err != nil {
+				s.logger.Error("failed to fetch latest height", "err", err)
+				continue
+			}
+			next, err = s.indexRange(ctx, next, tip)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// followNewBlocks subscribes to ws's NewBlock event stream and indexes every
+// committed height as it arrives, falling back to indexRange to cover any
+// heights the event stream skipped (e.g. a brief disconnect) so the indexer
+// never silently drops a block.
+func (s *Service) followNewBlocks(ctx context.Context, next int64, ws *wsclient.WSClient) error {
+	if err := ws.Subscribe(ctx, newBlockQuery); err != nil {
+		s.logger.Error("failed to subscribe to new block events, falling back to polling", "err", err)
+		return s.pollForNewBlocks(ctx, next)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case resp, ok := <-ws.ResponsesCh:
+			if !ok {
+				return s.pollForNewBlocks(ctx, next)
+			}
+			if resp.Error != nil {
+				s.logger.Error("comet WS error", "err", resp.Error)
+				continue
+			}
+
+			var result coretypes.ResultEvent
+			if err := cmtjson.Unmarshal(resp.Result, &result); err != nil {
+				continue
+			}
+			if result.Query != newBlockQuery {
+				continue
+			}
+			data, ok := result.Data.(cmttypes.EventDataNewBlock)
+			if !ok || data.Block == nil {
+				continue
+			}
+
+			var err error
+			next, err = s.indexRange(ctx, next, data.Block.Header.Height)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
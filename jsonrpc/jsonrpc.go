@@ -2,16 +2,11 @@ package jsonrpc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"net"
 	"net/http"
-	"time"
 
-	"cosmossdk.io/log"
 	"github.com/gorilla/mux"
-	ethns "github.com/initia-labs/minievm/jsonrpc/namespaces/eth"
-	"github.com/initia-labs/minievm/jsonrpc/namespaces/eth/filters"
-	netns "github.com/initia-labs/minievm/jsonrpc/namespaces/net"
 	"github.com/rs/cors"
 	"golang.org/x/net/netutil"
 	"golang.org/x/sync/errgroup"
@@ -24,9 +19,9 @@ import (
 
 	"github.com/initia-labs/minievm/app"
 	"github.com/initia-labs/minievm/jsonrpc/backend"
+	"github.com/initia-labs/minievm/jsonrpc/cometws"
 	"github.com/initia-labs/minievm/jsonrpc/config"
-
-	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	"github.com/initia-labs/minievm/jsonrpc/indexer"
 )
 
 // RPC namespaces and API version
@@ -35,10 +30,10 @@ const (
 	EthNamespace    = "eth"
 	NetNamespace    = "net"
 	TxPoolNamespace = "txpool"
+	DebugNamespace  = "debug"
 	// TODO: support more namespaces
 	Web3Namespace     = "web3"
 	PersonalNamespace = "personal"
-	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
 
 	apiVersion = "1.0"
@@ -53,45 +48,35 @@ func StartJSONRPC(
 	jsonRPCConfig config.JSONRPCConfig,
 ) error {
 
-	//TODO: use the rpcAddr parameter with reference to config.RPC.ListenAddress
-	cometWsClient := ConnectCometWS("http://127.0.0.1:26657", "/websocket", svrCtx.Logger)
-	if cometWsClient == nil {
-		return errors.New("failed to connect comet Websocket Server")
+	cometRPCAddr := cometws.NormalizeRPCListenAddress(svrCtx.Config.RPC.ListenAddress)
+	cometWsClient, cometReconnect, err := cometws.Connect(cometRPCAddr, "/websocket", svrCtx.Logger, jsonRPCConfig.CometWS)
+	if err != nil {
+		return fmt.Errorf("failed to connect comet Websocket Server: %w", err)
 	}
 
 	logger := svrCtx.Logger.With("module", "geth")
 	ethlog.SetDefault(ethlog.NewLogger(newLogger(logger)))
 
+	evmTxIndexer, err := indexer.NewEVMTxIndexer(svrCtx.Config.RootDir)
+	if err != nil {
+		return err
+	}
+
 	rpcServer := rpc.NewServer()
-	bkd := backend.NewJSONRPCBackend(app, svrCtx, clientCtx, jsonRPCConfig)
-	apis := []rpc.API{
-		{
-			Namespace: EthNamespace,
-			Version:   apiVersion,
-			Service:   ethns.NewEthAPI(svrCtx.Logger, bkd),
-			Public:    true,
-		},
-		{
-			Namespace: EthNamespace,
-			Version:   apiVersion,
-			Service:   filters.NewFilterAPI(svrCtx.Logger, bkd, clientCtx, cometWsClient),
-			Public:    true,
-		},
-		{
-			Namespace: NetNamespace,
-			Version:   apiVersion,
-			Service:   netns.NewNetAPI(svrCtx.Logger, bkd),
-			Public:    true,
-		},
-		// TODO: implement more namespaces
-		//{
-		//	Namespace: TxPoolNamespace,
-		//	Version:   apiVersion,
-		//	Service:   txpool.NewTxPoolAPI(svrCtx.Logger, bkd),
-		//	Public:    true,
-		//},
+	bkd := backend.NewJSONRPCBackend(app, svrCtx, clientCtx, jsonRPCConfig, evmTxIndexer)
+
+	indexerSvc := indexer.NewService(svrCtx.Logger, evmTxIndexer, app, bkd)
+	if err := indexerSvc.Start(ctx, g, clientCtx, cometWsClient); err != nil {
+		return err
 	}
 
+	apis, err := GetRPCAPIs(svrCtx, clientCtx, bkd, cometWsClient, cometReconnect, jsonRPCConfig, jsonRPCConfig.API)
+	if err != nil {
+		return err
+	}
+
+	svrCtx.Logger.Info("registering JSON-RPC namespaces", "namespaces", jsonRPCConfig.API)
+
 	for _, api := range apis {
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
 			svrCtx.Logger.Error(
@@ -149,6 +134,69 @@ func StartJSONRPC(
 		}
 	})
 
+	if jsonRPCConfig.EnableWS {
+		if err := startWSServer(ctx, g, rpcServer, svrCtx, jsonRPCConfig); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// startWSServer starts a second listener that upgrades incoming connections
+// to WebSocket and serves the same registered APIs, so that eth_subscribe /
+// eth_unsubscribe work alongside the existing HTTP POST endpoint.
+func startWSServer(
+	ctx context.Context,
+	g *errgroup.Group,
+	rpcServer *rpc.Server,
+	svrCtx *server.Context,
+	jsonRPCConfig config.JSONRPCConfig,
+) error {
+	wsHandler := rpcServer.WebsocketHandler(corsAllowedOrigins(jsonRPCConfig))
+
+	wsSrv := &http.Server{
+		Addr:              jsonRPCConfig.WSAddress,
+		Handler:           wsHandler,
+		ReadHeaderTimeout: jsonRPCConfig.HTTPTimeout,
+	}
+
+	ln, err := listen(wsSrv.Addr, jsonRPCConfig)
+	if err != nil {
+		return err
+	}
+
+	g.Go(func() error {
+		errCh := make(chan error)
+
+		go func() {
+			svrCtx.Logger.Info("Starting JSON-RPC WebSocket server", "address", jsonRPCConfig.WSAddress)
+			errCh <- wsSrv.Serve(ln)
+		}()
+
+		select {
+		case <-ctx.Done():
+			svrCtx.Logger.Info("stopping Ethereum JSONRPC WebSocket server...", "address", jsonRPCConfig.WSAddress)
+			return wsSrv.Close()
+
+		case err := <-errCh:
+			svrCtx.Logger.Error("failed to start Ethereum JSONRPC WebSocket server", "err", err)
+			return err
+		}
+	})
+
+	return nil
+}
+
+// corsAllowedOrigins returns the list of origins the WS handler accepts.
+// With EnableUnsafeCORS every origin is accepted. Otherwise it returns nil,
+// for which go-ethereum's WebsocketHandler accepts only localhost and the
+// node's own hostname as origins; unlike the HTTP handler's cors.Default(),
+// other origins are rejected.
+func corsAllowedOrigins(jsonRPCConfig config.JSONRPCConfig) []string {
+	if jsonRPCConfig.EnableUnsafeCORS {
+		return []string{"*"}
+	}
 	return nil
 }
 
@@ -167,36 +215,3 @@ func listen(addr string, jsonRPCConfig config.JSONRPCConfig) (net.Listener, erro
 	}
 	return ln, err
 }
-
-// reference: https://github.com/evmos/ethermint/blob/fd8c2d25cf80e7d2d2a142e7b374f979f8f51981/server/util.go#L74
-func ConnectCometWS(cometRPCAddr, cometWSEndpoint string, logger log.Logger) *rpcclient.WSClient {
-	cometWSClient, err := rpcclient.NewWS(cometRPCAddr, cometWSEndpoint,
-		//TODO: make the following values configurable
-		rpcclient.MaxReconnectAttempts(256),
-		rpcclient.ReadWait(0),
-		// If readWait is not zero, pingPeriod must be less than readWait to avoid abnormal closure.
-		// https://github.com/initia-labs/cometbft/blob/6c77a401128cb7dd8368ba8fbe7f30caf4fffa96/rpc/jsonrpc/client/ws_client.go#L77
-		// Once the connection is lost, subscribed events can be deferred while reconnecting.
-		rpcclient.WriteWait(0),
-		rpcclient.PingPeriod(50*time.Second),
-		rpcclient.OnReconnect(func() {
-			logger.Debug("EVM RPC reconnects to Comet WS", "address", cometRPCAddr+cometWSEndpoint)
-		}),
-	)
-
-	if err != nil {
-		logger.Error(
-			"Comet WS client could not be created",
-			"address", cometRPCAddr+cometWSEndpoint,
-			"error", err,
-		)
-	} else if err := cometWSClient.OnStart(); err != nil {
-		logger.Error(
-			"Comet WS client could not start",
-			"address", cometRPCAddr+cometWSEndpoint,
-			"error", err,
-		)
-	}
-
-	return cometWSClient
-}
@@ -0,0 +1,304 @@
+package filters
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/minievm/jsonrpc/cometws"
+)
+
+const (
+	newBlockQuery = "tm.event='NewBlock'"
+	txQuery       = "tm.event='Tx'"
+
+	eventChanCap = 128
+
+	// syncingPollInterval is how often the Syncing subscription's status is
+	// refreshed. It only does any work when at least one subscriber is
+	// registered.
+	syncingPollInterval = 2 * time.Second
+)
+
+// cometStatusClient is the subset of client.Context.Client the Syncing
+// subscription needs to learn the node's catch-up status.
+type cometStatusClient interface {
+	Status(ctx context.Context) (*coretypes.ResultStatus, error)
+}
+
+// eventBroker keeps a single CometBFT WS subscription per node instance and
+// fans the resulting NewBlock/Tx events out to every registered client
+// channel, translating them into Ethereum-style payloads along the way.
+type eventBroker struct {
+	logger    log.Logger
+	backend   Backend
+	cometWS   *rpcclient.WSClient
+	status    cometStatusClient
+	txDecoder sdk.TxDecoder
+
+	mu          sync.Mutex
+	headsSubs   map[chan *ethtypes.Header]struct{}
+	logsSubs    map[chan []*ethtypes.Log]FilterCriteria
+	pendingSubs map[chan common.Hash]struct{}
+	syncingSubs map[chan *SyncingResult]struct{}
+}
+
+func newEventBroker(logger log.Logger, backend Backend, status cometStatusClient, txDecoder sdk.TxDecoder, cometWS *rpcclient.WSClient, cometReconnect *cometws.ReconnectNotifier) *eventBroker {
+	b := &eventBroker{
+		logger:      logger,
+		backend:     backend,
+		status:      status,
+		txDecoder:   txDecoder,
+		cometWS:     cometWS,
+		headsSubs:   make(map[chan *ethtypes.Header]struct{}),
+		logsSubs:    make(map[chan []*ethtypes.Log]FilterCriteria),
+		pendingSubs: make(map[chan common.Hash]struct{}),
+		syncingSubs: make(map[chan *SyncingResult]struct{}),
+	}
+
+	if cometWS != nil {
+		b.subscribe()
+		if cometReconnect != nil {
+			// Re-establish our NewBlock/Tx subscriptions on every reconnect so
+			// eth_subscribe consumers don't silently stop receiving events
+			// after a CometBFT blip.
+			cometReconnect.OnReconnect(b.subscribe)
+		}
+		go b.start()
+		go b.runSyncingLoop()
+	}
+
+	return b
+}
+
+func (b *eventBroker) subscribe() {
+	if err := b.cometWS.Subscribe(context.Background(), newBlockQuery); err != nil {
+		b.logger.Error("failed to subscribe to new block events", "err", err)
+	}
+	if err := b.cometWS.Subscribe(context.Background(), txQuery); err != nil {
+		b.logger.Error("failed to subscribe to tx events", "err", err)
+	}
+}
+
+func (b *eventBroker) start() {
+	for resp := range b.cometWS.ResponsesCh {
+		if resp.Error != nil {
+			b.logger.Error("comet WS error", "err", resp.Error)
+			continue
+		}
+
+		var result coretypes.ResultEvent
+		if err := jsonUnmarshal(resp.Result, &result); err != nil {
+			continue
+		}
+
+		switch result.Query {
+		case newBlockQuery:
+			b.dispatchHead(result)
+		case txQuery:
+			b.dispatchTx(result)
+		}
+	}
+}
+
+func (b *eventBroker) dispatchHead(result coretypes.ResultEvent) {
+	header, logs, err := b.headerAndLogsFromEvent(result)
+	if err != nil {
+		b.logger.Error("failed to translate NewBlock event", "err", err)
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.headsSubs {
+		select {
+		case ch <- header:
+		default:
+		}
+	}
+
+	for ch, crit := range b.logsSubs {
+		matched := filterLogs(logs, crit)
+		if len(matched) == 0 {
+			continue
+		}
+		select {
+		case ch <- matched:
+		default:
+		}
+	}
+}
+
+func (b *eventBroker) dispatchTx(result coretypes.ResultEvent) {
+	ethHash, err := b.ethTxHashFromEvent(result)
+	if err != nil {
+		b.logger.Error("failed to translate Tx event", "err", err)
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.pendingSubs {
+		select {
+		case ch <- ethHash:
+		default:
+		}
+	}
+}
+
+// runSyncingLoop periodically polls the node's sync status and dispatches it
+// to every Syncing subscriber. It skips the RPC round-trip entirely when
+// nobody is subscribed.
+func (b *eventBroker) runSyncingLoop() {
+	ticker := time.NewTicker(syncingPollInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		b.mu.Lock()
+		n := len(b.syncingSubs)
+		b.mu.Unlock()
+		if n == 0 {
+			continue
+		}
+
+		status, err := b.status.Status(context.Background())
+		if err != nil {
+			b.logger.Error("failed to fetch sync status", "err", err)
+			continue
+		}
+
+		result := &SyncingResult{
+			Syncing:      status.SyncInfo.CatchingUp,
+			CurrentBlock: uint64(status.SyncInfo.LatestBlockHeight),
+			HighestBlock: uint64(status.SyncInfo.LatestBlockHeight),
+		}
+
+		b.dispatchSyncing(result)
+	}
+}
+
+func (b *eventBroker) dispatchSyncing(result *SyncingResult) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.syncingSubs {
+		select {
+		case ch <- result:
+		default:
+		}
+	}
+}
+
+func (b *eventBroker) subscribeHeads() chan *ethtypes.Header {
+	ch := make(chan *ethtypes.Header, eventChanCap)
+	b.mu.Lock()
+	b.headsSubs[ch] = struct{}{}
+	b.mu.Unlock()
+	return ch
+}
+
+func (b *eventBroker) unsubscribeHeads(ch chan *ethtypes.Header) {
+	b.mu.Lock()
+	delete(b.headsSubs, ch)
+	b.mu.Unlock()
+}
+
+func (b *eventBroker) subscribeLogs(crit FilterCriteria) chan []*ethtypes.Log {
+	ch := make(chan []*ethtypes.Log, eventChanCap)
+	b.mu.Lock()
+	b.logsSubs[ch] = crit
+	b.mu.Unlock()
+	return ch
+}
+
+func (b *eventBroker) unsubscribeLogs(ch chan []*ethtypes.Log) {
+	b.mu.Lock()
+	delete(b.logsSubs, ch)
+	b.mu.Unlock()
+}
+
+func (b *eventBroker) subscribePendingTxs() chan common.Hash {
+	ch := make(chan common.Hash, eventChanCap)
+	b.mu.Lock()
+	b.pendingSubs[ch] = struct{}{}
+	b.mu.Unlock()
+	return ch
+}
+
+func (b *eventBroker) unsubscribePendingTxs(ch chan common.Hash) {
+	b.mu.Lock()
+	delete(b.pendingSubs, ch)
+	b.mu.Unlock()
+}
+
+func (b *eventBroker) subscribeSyncing() chan *SyncingResult {
+	ch := make(chan *SyncingResult, eventChanCap)
+	b.mu.Lock()
+	b.syncingSubs[ch] = struct{}{}
+	b.mu.Unlock()
+	return ch
+}
+
+func (b *eventBroker) unsubscribeSyncing(ch chan *SyncingResult) {
+	b.mu.Lock()
+	delete(b.syncingSubs, ch)
+	b.mu.Unlock()
+}
+
+func filterLogs(logs []*ethtypes.Log, crit FilterCriteria) []*ethtypes.Log {
+	var out []*ethtypes.Log
+	for _, lg := range logs {
+		if !matchesCriteria(lg, crit) {
+			continue
+		}
+		out = append(out, lg)
+	}
+	return out
+}
+
+func matchesCriteria(lg *ethtypes.Log, crit FilterCriteria) bool {
+	if len(crit.Addresses) > 0 {
+		found := false
+		for _, addr := range crit.Addresses {
+			if addr == lg.Address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	for i, topics := range crit.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+		if i >= len(lg.Topics) {
+			return false
+		}
+		found := false
+		for _, topic := range topics {
+			if topic == lg.Topics[i] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
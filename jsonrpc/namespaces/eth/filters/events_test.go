@@ -0,0 +1,61 @@
+package filters
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestMatchesCriteria(t *testing.T) {
+	addrA := common.HexToAddress("0xaaaa000000000000000000000000000000aaaa")
+	addrB := common.HexToAddress("0xbbbb000000000000000000000000000000bbbb")
+	topicA := common.HexToHash("0x01")
+	topicB := common.HexToHash("0x02")
+
+	lg := &ethtypes.Log{
+		Address: addrA,
+		Topics:  []common.Hash{topicA, topicB},
+	}
+
+	cases := []struct {
+		name string
+		crit FilterCriteria
+		want bool
+	}{
+		{"no criteria matches everything", FilterCriteria{}, true},
+		{"matching address", FilterCriteria{Addresses: []common.Address{addrA}}, true},
+		{"non-matching address", FilterCriteria{Addresses: []common.Address{addrB}}, false},
+		{"matching first topic", FilterCriteria{Topics: [][]common.Hash{{topicA}}}, true},
+		{"non-matching first topic", FilterCriteria{Topics: [][]common.Hash{{topicB}}}, false},
+		{"wildcard first topic, matching second", FilterCriteria{Topics: [][]common.Hash{{}, {topicB}}}, true},
+		{"topic position beyond log's topics", FilterCriteria{Topics: [][]common.Hash{{}, {}, {topicA}}}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := matchesCriteria(lg, tc.crit); got != tc.want {
+				t.Errorf("matchesCriteria() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFilterLogs(t *testing.T) {
+	addrA := common.HexToAddress("0xaaaa000000000000000000000000000000aaaa")
+	addrB := common.HexToAddress("0xbbbb000000000000000000000000000000bbbb")
+
+	logs := []*ethtypes.Log{
+		{Address: addrA},
+		{Address: addrB},
+	}
+
+	matched := filterLogs(logs, FilterCriteria{Addresses: []common.Address{addrA}})
+	if len(matched) != 1 || matched[0].Address != addrA {
+		t.Fatalf("expected exactly one matching log for addrA, got %v", matched)
+	}
+
+	if matched := filterLogs(logs, FilterCriteria{}); len(matched) != 2 {
+		t.Fatalf("expected all logs to match empty criteria, got %d", len(matched))
+	}
+}
@@ -0,0 +1,188 @@
+package filters
+
+import (
+	"context"
+	"sync"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+
+	"github.com/initia-labs/minievm/jsonrpc/cometws"
+)
+
+// Backend is the subset of the JSON-RPC backend that the filters API needs
+// in order to serve both one-shot queries and long-lived subscriptions.
+type Backend interface {
+	HeaderByNumber(ctx context.Context, blockNum rpc.BlockNumber) (*ethtypes.Header, error)
+	GetLogs(ctx context.Context, blockHash common.Hash) ([][]*ethtypes.Log, error)
+	BloomStatus() (uint64, uint64)
+}
+
+// FilterAPI exposes the eth subscriptions served through eth_subscribe /
+// eth_unsubscribe (newHeads, logs, newPendingTransactions, syncing); the
+// polling filter methods (eth_newFilter, eth_getFilterChanges, ...) are not
+// implemented here. Subscriptions
+// are multiplexed onto a single CometBFT WS subscription per node instance
+// and fanned out to every subscribed client.
+type FilterAPI struct {
+	logger    log.Logger
+	backend   Backend
+	clientCtx client.Context
+	cometWS   *rpcclient.WSClient
+
+	mu     sync.Mutex
+	events *eventBroker
+}
+
+// NewFilterAPI returns a new FilterAPI backed by the given CometBFT
+// WebSocket client. Only one eventBroker is created per FilterAPI instance,
+// so all subscribers share the same upstream comet subscriptions. The
+// reconnect notifier lets the broker re-subscribe automatically whenever
+// the underlying comet WS connection blips and reconnects.
+func NewFilterAPI(logger log.Logger, backend Backend, clientCtx client.Context, cometWS *rpcclient.WSClient, cometReconnect *cometws.ReconnectNotifier) *FilterAPI {
+	api := &FilterAPI{
+		logger:    logger.With("module", "eth-filters"),
+		backend:   backend,
+		clientCtx: clientCtx,
+		cometWS:   cometWS,
+	}
+	api.events = newEventBroker(api.logger, backend, clientCtx.Client, clientCtx.TxConfig.TxDecoder(), cometWS, cometReconnect)
+	return api
+}
+
+// NewHeads sends a notification each time a new block header is appended to
+// the chain.
+func (api *FilterAPI) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+	headers := api.events.subscribeHeads()
+
+	go func() {
+		defer api.events.unsubscribeHeads(headers)
+		for {
+			select {
+			case h := <-headers:
+				_ = notifier.Notify(rpcSub.ID, h)
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
+// FilterCriteria mirrors eth_newFilter's log filter argument.
+type FilterCriteria struct {
+	BlockHash *common.Hash     `json:"blockHash"`
+	FromBlock *rpc.BlockNumber `json:"fromBlock"`
+	ToBlock   *rpc.BlockNumber `json:"toBlock"`
+	Addresses []common.Address `json:"address"`
+	Topics    [][]common.Hash  `json:"topics"`
+}
+
+// Logs creates a subscription that fires for every log matching the given
+// criteria as new blocks are committed.
+func (api *FilterAPI) Logs(ctx context.Context, crit FilterCriteria) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+	logsCh := api.events.subscribeLogs(crit)
+
+	go func() {
+		defer api.events.unsubscribeLogs(logsCh)
+		for {
+			select {
+			case lg := <-logsCh:
+				_ = notifier.Notify(rpcSub.ID, lg)
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
+// NewPendingTransactions creates a subscription that is triggered by
+// CometBFT's Tx event, which fires when a transaction is executed in a
+// committed block; a transaction that has only entered the mempool is not
+// reported. The Cosmos tx wrapper is decoded back into its Ethereum tx hash
+// before being delivered to the client.
+func (api *FilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+	txs := api.events.subscribePendingTxs()
+
+	go func() {
+		defer api.events.unsubscribePendingTxs(txs)
+		for {
+			select {
+			case hash := <-txs:
+				_ = notifier.Notify(rpcSub.ID, hash)
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
+// SyncingResult mirrors geth's eth_syncing subscription payload.
+type SyncingResult struct {
+	Syncing      bool   `json:"syncing"`
+	CurrentBlock uint64 `json:"currentBlock"`
+	HighestBlock uint64 `json:"highestBlock"`
+}
+
+// Syncing creates a subscription that sends the client the node's current
+// syncing status every syncingPollInterval, whether or not it has changed.
+func (api *FilterAPI) Syncing(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+	statuses := api.events.subscribeSyncing()
+
+	go func() {
+		defer api.events.unsubscribeSyncing(statuses)
+		for {
+			select {
+			case s := <-statuses:
+				_ = notifier.Notify(rpcSub.ID, s)
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
@@ -0,0 +1,76 @@
+package filters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/initia-labs/minievm/jsonrpc/evmtx"
+)
+
+// jsonUnmarshal decodes a CometBFT WS payload. result.Data is the
+// polymorphic cmttypes.TMEventData interface, which only decodes correctly
+// through cmtjson's type-registry-aware Unmarshal (see cmtjson.RegisterType)
+// — stdlib encoding/json would leave it as a bare map[string]interface{}.
+func jsonUnmarshal(raw json.RawMessage, v interface{}) error {
+	if len(raw) == 0 {
+		return errors.New("empty comet WS event payload")
+	}
+	return cmtjson.Unmarshal(raw, v)
+}
+
+// headerAndLogsFromEvent decodes a CometBFT NewBlock event into an
+// Ethereum-style header plus the logs emitted by every EVM tx in the block.
+// The event only tells us which height just committed; the canonical header
+// itself is fetched through Backend.HeaderByNumber so that newHeads payloads
+// and GetLogs-by-hash lookups agree with eth_getBlockByNumber/ByHash for the
+// same block instead of hashing a second, divergent header.
+func (b *eventBroker) headerAndLogsFromEvent(result coretypes.ResultEvent) (*ethtypes.Header, []*ethtypes.Log, error) {
+	data, ok := result.Data.(cmttypes.EventDataNewBlock)
+	if !ok || data.Block == nil {
+		return nil, nil, fmt.Errorf("filters: unexpected NewBlock event data type %T", result.Data)
+	}
+
+	ctx := context.Background()
+	header, err := b.backend.HeaderByNumber(ctx, rpc.BlockNumber(data.Block.Header.Height))
+	if err != nil {
+		return nil, nil, fmt.Errorf("filters: failed to fetch header for block %d: %w", data.Block.Header.Height, err)
+	}
+
+	blockLogs, err := b.backend.GetLogs(ctx, header.Hash())
+	if err != nil {
+		return nil, nil, fmt.Errorf("filters: failed to fetch logs for block %d: %w", header.Number, err)
+	}
+
+	var logs []*ethtypes.Log
+	for _, txLogs := range blockLogs {
+		logs = append(logs, txLogs...)
+	}
+
+	return header, logs, nil
+}
+
+// ethTxHashFromEvent decodes a CometBFT Tx event's wrapped Cosmos tx into
+// its underlying Ethereum transaction hash.
+func (b *eventBroker) ethTxHashFromEvent(result coretypes.ResultEvent) (common.Hash, error) {
+	data, ok := result.Data.(cmttypes.EventDataTx)
+	if !ok {
+		return common.Hash{}, fmt.Errorf("filters: unexpected Tx event data type %T", result.Data)
+	}
+
+	ethTx, err := evmtx.Decode(b.txDecoder, data.Tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return ethTx.Hash(), nil
+}
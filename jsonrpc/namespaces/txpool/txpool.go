@@ -0,0 +1,262 @@
+package txpool
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	comettypes "github.com/cometbft/cometbft/types"
+
+	"github.com/initia-labs/minievm/jsonrpc/evmtx"
+)
+
+// Backend is the subset of the JSON-RPC backend the txpool namespace needs:
+// decoding a wrapped Cosmos tx back into its underlying Ethereum
+// transaction, and reading an account's current (committed) nonce so
+// pending-vs-queued classification matches geth's semantics.
+type Backend interface {
+	DecodeEthTx(tx comettypes.Tx) (*ethtypes.Transaction, error)
+	Nonce(ctx context.Context, address common.Address) (uint64, error)
+}
+
+// TxPoolAPI implements the txpool_* namespace backed directly by the local
+// CometBFT node's mempool, since CometBFT has no separate RPC for it.
+type TxPoolAPI struct {
+	logger    log.Logger
+	backend   Backend
+	clientCtx client.Context
+}
+
+// NewTxPoolAPI returns a new TxPoolAPI.
+func NewTxPoolAPI(logger log.Logger, backend Backend, clientCtx client.Context) *TxPoolAPI {
+	return &TxPoolAPI{
+		logger:    logger.With("module", "txpool"),
+		backend:   backend,
+		clientCtx: clientCtx,
+	}
+}
+
+// RPCTransaction is the subset of geth's RPCTransaction fields the txpool
+// namespace reports per pending/queued entry.
+type RPCTransaction struct {
+	Hash     common.Hash     `json:"hash"`
+	Nonce    hexutil.Uint64  `json:"nonce"`
+	From     common.Address  `json:"from"`
+	To       *common.Address `json:"to"`
+	Value    *hexutil.Big    `json:"value"`
+	Gas      hexutil.Uint64  `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+}
+
+// groupedByAddress maps a sender address to its nonce-keyed txs, matching
+// geth's txpool_content response shape.
+type groupedByAddress map[common.Address]map[uint64]*RPCTransaction
+
+// Status returns the number of pending and queued transactions in the pool.
+func (api *TxPoolAPI) Status(ctx context.Context) (map[string]hexutil.Uint, error) {
+	pending, queued, err := api.classify(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]hexutil.Uint{
+		"pending": hexutil.Uint(countTxs(pending)),
+		"queued":  hexutil.Uint(countTxs(queued)),
+	}, nil
+}
+
+// Content returns every pending and queued transaction, grouped by sender
+// address and nonce.
+func (api *TxPoolAPI) Content(ctx context.Context) (map[string]map[string]map[string]*RPCTransaction, error) {
+	pending, queued, err := api.classify(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]map[string]map[string]*RPCTransaction{
+		"pending": formatGrouped(pending),
+		"queued":  formatGrouped(queued),
+	}, nil
+}
+
+// ContentFrom returns the pending/queued transactions sent by a single
+// address.
+func (api *TxPoolAPI) ContentFrom(ctx context.Context, address common.Address) (map[string]map[string]*RPCTransaction, error) {
+	pending, queued, err := api.classify(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]map[string]*RPCTransaction{
+		"pending": formatAddress(pending[address]),
+		"queued":  formatAddress(queued[address]),
+	}, nil
+}
+
+// Inspect returns a human-readable summary of the pool, geth's
+// txpool_inspect shape.
+func (api *TxPoolAPI) Inspect(ctx context.Context) (map[string]map[string]map[string]string, error) {
+	pending, queued, err := api.classify(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]map[string]map[string]string{
+		"pending": inspectGrouped(pending),
+		"queued":  inspectGrouped(queued),
+	}, nil
+}
+
+// classify fetches every unconfirmed tx from the local CometBFT mempool,
+// decodes it back into its Ethereum transaction, and splits the result into
+// pending (nonce == account's current nonce, or a later nonce already
+// contiguous with a pending one) and queued (nonce gap) groups, since
+// CometBFT itself has no notion of queued transactions.
+func (api *TxPoolAPI) classify(ctx context.Context) (pending, queued groupedByAddress, err error) {
+	// A nil limit maps to CometBFT's validatePerPage default of 30, so fetch
+	// the true count first and request exactly that many. CometBFT still caps
+	// the limit at 100, so with more than 100 unconfirmed txs only the first
+	// 100 are classified and reported.
+	numRes, err := api.clientCtx.Client.NumUnconfirmedTxs(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch unconfirmed tx count: %w", err)
+	}
+
+	limit := numRes.Total
+	res, err := api.clientCtx.Client.UnconfirmedTxs(ctx, &limit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch unconfirmed txs: %w", err)
+	}
+
+	bySender := make(map[common.Address]map[uint64]*RPCTransaction)
+	for _, tx := range res.Txs {
+		ethTx, decodeErr := api.backend.DecodeEthTx(tx)
+		if decodeErr != nil {
+			api.logger.Debug("skipping non-EVM tx in mempool", "err", decodeErr)
+			continue
+		}
+
+		from, sigErr := evmtx.Sender(ethTx)
+		if sigErr != nil {
+			api.logger.Debug("failed to recover tx sender, skipping", "hash", ethTx.Hash(), "err", sigErr)
+			continue
+		}
+
+		if bySender[from] == nil {
+			bySender[from] = make(map[uint64]*RPCTransaction)
+		}
+		bySender[from][ethTx.Nonce()] = toRPCTransaction(ethTx, from)
+	}
+
+	pending = make(groupedByAddress)
+	queued = make(groupedByAddress)
+
+	for addr, txs := range bySender {
+		accountNonce, nonceErr := api.backend.Nonce(ctx, addr)
+		if nonceErr != nil {
+			return nil, nil, fmt.Errorf("failed to fetch nonce for %s: %w", addr, nonceErr)
+		}
+
+		addrPending, addrQueued := classifyNonces(accountNonce, txs)
+		if len(addrPending) > 0 {
+			pending[addr] = addrPending
+		}
+		if len(addrQueued) > 0 {
+			queued[addr] = addrQueued
+		}
+	}
+
+	return pending, queued, nil
+}
+
+// classifyNonces splits a single sender's nonce-keyed txs into pending
+// (contiguous with accountNonce) and queued (a nonce gap behind them), the
+// same way geth's txpool distinguishes executable from non-executable
+// transactions.
+func classifyNonces(accountNonce uint64, txs map[uint64]*RPCTransaction) (pending, queued map[uint64]*RPCTransaction) {
+	pending = make(map[uint64]*RPCTransaction)
+	queued = make(map[uint64]*RPCTransaction)
+
+	nonces := make([]uint64, 0, len(txs))
+	for nonce := range txs {
+		nonces = append(nonces, nonce)
+	}
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+	expected := accountNonce
+	for _, nonce := range nonces {
+		if nonce == expected {
+			pending[nonce] = txs[nonce]
+			expected++
+		} else {
+			queued[nonce] = txs[nonce]
+		}
+	}
+
+	return pending, queued
+}
+
+func toRPCTransaction(tx *ethtypes.Transaction, from common.Address) *RPCTransaction {
+	return &RPCTransaction{
+		Hash:     tx.Hash(),
+		Nonce:    hexutil.Uint64(tx.Nonce()),
+		From:     from,
+		To:       tx.To(),
+		Value:    (*hexutil.Big)(tx.Value()),
+		Gas:      hexutil.Uint64(tx.Gas()),
+		GasPrice: (*hexutil.Big)(tx.GasPrice()),
+	}
+}
+
+func countTxs(grouped groupedByAddress) int {
+	count := 0
+	for _, txs := range grouped {
+		count += len(txs)
+	}
+	return count
+}
+
+func formatGrouped(grouped groupedByAddress) map[string]map[string]*RPCTransaction {
+	out := make(map[string]map[string]*RPCTransaction, len(grouped))
+	for addr, txs := range grouped {
+		out[addr.Hex()] = formatAddress(txs)
+	}
+	return out
+}
+
+func formatAddress(txs map[uint64]*RPCTransaction) map[string]*RPCTransaction {
+	out := make(map[string]*RPCTransaction, len(txs))
+	for nonce, tx := range txs {
+		out[fmt.Sprintf("%d", nonce)] = tx
+	}
+	return out
+}
+
+func inspectGrouped(grouped groupedByAddress) map[string]map[string]string {
+	out := make(map[string]map[string]string, len(grouped))
+	for addr, txs := range grouped {
+		entries := make(map[string]string, len(txs))
+		for nonce, tx := range txs {
+			entries[fmt.Sprintf("%d", nonce)] = fmt.Sprintf(
+				"%s: %s wei + %d gas × %s wei",
+				addrOrNil(tx.To), tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt(),
+			)
+		}
+		out[addr.Hex()] = entries
+	}
+	return out
+}
+
+func addrOrNil(to *common.Address) string {
+	if to == nil {
+		return "contract creation"
+	}
+	return to.Hex()
+}
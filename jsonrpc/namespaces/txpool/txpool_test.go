@@ -0,0 +1,68 @@
+package txpool
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestClassifyNonces(t *testing.T) {
+	txs := map[uint64]*RPCTransaction{
+		5: {},
+		6: {},
+		8: {},
+	}
+
+	pending, queued := classifyNonces(5, txs)
+
+	if len(pending) != 2 {
+		t.Fatalf("expected 2 pending txs (nonces 5,6), got %d", len(pending))
+	}
+	if _, ok := pending[5]; !ok {
+		t.Error("expected nonce 5 to be pending")
+	}
+	if _, ok := pending[6]; !ok {
+		t.Error("expected nonce 6 to be pending")
+	}
+
+	if len(queued) != 1 {
+		t.Fatalf("expected 1 queued tx (nonce 8, gap after 6), got %d", len(queued))
+	}
+	if _, ok := queued[8]; !ok {
+		t.Error("expected nonce 8 to be queued due to the gap at nonce 7")
+	}
+}
+
+func TestClassifyNoncesAllQueuedWhenAheadOfAccountNonce(t *testing.T) {
+	txs := map[uint64]*RPCTransaction{
+		10: {},
+		11: {},
+	}
+
+	// account nonce is 0, far behind the tx nonces: nothing is executable yet.
+	pending, queued := classifyNonces(0, txs)
+
+	if len(pending) != 0 {
+		t.Fatalf("expected no pending txs, got %d", len(pending))
+	}
+	if len(queued) != 2 {
+		t.Fatalf("expected both txs queued, got %d", len(queued))
+	}
+}
+
+func TestFormatGrouped(t *testing.T) {
+	addr := common.HexToAddress("0xaaaa000000000000000000000000000000aaaa")
+	grouped := groupedByAddress{
+		addr: {
+			1: {},
+		},
+	}
+
+	out := formatGrouped(grouped)
+	if _, ok := out[addr.Hex()]; !ok {
+		t.Fatalf("expected formatted output to be keyed by address hex, got %v", out)
+	}
+	if _, ok := out[addr.Hex()]["1"]; !ok {
+		t.Fatalf("expected nonce 1 entry keyed by decimal string, got %v", out[addr.Hex()])
+	}
+}
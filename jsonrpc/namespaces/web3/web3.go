@@ -0,0 +1,32 @@
+package web3
+
+import (
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Web3API implements the web3_* namespace.
+type Web3API struct {
+	logger        log.Logger
+	clientVersion string
+}
+
+// NewWeb3API returns a new Web3API reporting the given client version
+// string in web3_clientVersion.
+func NewWeb3API(logger log.Logger, clientVersion string) *Web3API {
+	return &Web3API{
+		logger:        logger.With("module", "web3"),
+		clientVersion: clientVersion,
+	}
+}
+
+// ClientVersion returns the node's client version string.
+func (api *Web3API) ClientVersion() string {
+	return api.clientVersion
+}
+
+// Sha3 returns the Keccak-256 hash of the given data.
+func (api *Web3API) Sha3(input hexutil.Bytes) hexutil.Bytes {
+	return crypto.Keccak256(input)
+}
@@ -0,0 +1,113 @@
+package jsonrpc
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	ethns "github.com/initia-labs/minievm/jsonrpc/namespaces/eth"
+	"github.com/initia-labs/minievm/jsonrpc/namespaces/eth/filters"
+	netns "github.com/initia-labs/minievm/jsonrpc/namespaces/net"
+	"github.com/initia-labs/minievm/jsonrpc/namespaces/txpool"
+	"github.com/initia-labs/minievm/jsonrpc/namespaces/web3"
+
+	"github.com/initia-labs/minievm/jsonrpc/backend"
+	"github.com/initia-labs/minievm/jsonrpc/cometws"
+	"github.com/initia-labs/minievm/jsonrpc/config"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+)
+
+// knownNamespaces lists every namespace this node recognizes, whether or
+// not it has a registered builder below. Namespaces implemented by name
+// only (no builder) exist so that an operator listing them in
+// JSONRPCConfig.API gets a clear "not implemented" error instead of
+// "unknown namespace".
+var knownNamespaces = map[string]bool{
+	EthNamespace:      true,
+	NetNamespace:      true,
+	Web3Namespace:     true,
+	TxPoolNamespace:   true,
+	DebugNamespace:    true,
+	PersonalNamespace: true,
+	MinerNamespace:    true,
+}
+
+// GetRPCAPIs conditionally builds the rpc.API set for every namespace named
+// in enabled, refusing to start if an unknown or not-yet-implemented
+// namespace is listed.
+//
+// reference: https://github.com/evmos/ethermint/blob/fd8c2d25cf80e7d2d2a142e7b374f979f8f51981/server/json_rpc.go
+func GetRPCAPIs(
+	svrCtx *server.Context,
+	clientCtx client.Context,
+	bkd *backend.JSONRPCBackend,
+	wsClient *rpcclient.WSClient,
+	cometReconnect *cometws.ReconnectNotifier,
+	jsonRPCConfig config.JSONRPCConfig,
+	enabled []string,
+) ([]rpc.API, error) {
+	var apis []rpc.API
+
+	for _, name := range enabled {
+		if !knownNamespaces[name] {
+			return nil, fmt.Errorf("unknown JSON-RPC namespace %q", name)
+		}
+
+		switch name {
+		case EthNamespace:
+			apis = append(apis,
+				rpc.API{
+					Namespace: EthNamespace,
+					Version:   apiVersion,
+					Service:   ethns.NewEthAPI(svrCtx.Logger, bkd),
+					Public:    true,
+				},
+				rpc.API{
+					Namespace: EthNamespace,
+					Version:   apiVersion,
+					Service:   filters.NewFilterAPI(svrCtx.Logger, bkd, clientCtx, wsClient, cometReconnect),
+					Public:    true,
+				},
+			)
+		case NetNamespace:
+			apis = append(apis, rpc.API{
+				Namespace: NetNamespace,
+				Version:   apiVersion,
+				Service:   netns.NewNetAPI(svrCtx.Logger, bkd),
+				Public:    true,
+			})
+		case Web3Namespace:
+			apis = append(apis, rpc.API{
+				Namespace: Web3Namespace,
+				Version:   apiVersion,
+				Service:   web3.NewWeb3API(svrCtx.Logger, clientVersion()),
+				Public:    true,
+			})
+		case TxPoolNamespace:
+			apis = append(apis, rpc.API{
+				Namespace: TxPoolNamespace,
+				Version:   apiVersion,
+				Service:   txpool.NewTxPoolAPI(svrCtx.Logger, bkd, clientCtx),
+				Public:    true,
+			})
+		case DebugNamespace:
+			// debug_trace*/debug_storageRangeAt require replaying historical
+			// EVM state through the x/evm keeper, which this backend has no
+			// access to. Refuse to register the namespace at all rather than
+			// exposing RPC methods that fail on every call.
+			return nil, fmt.Errorf("JSON-RPC namespace %q is recognized but not implemented yet: debug_trace* requires replaying EVM state through the x/evm keeper, which this backend is not wired up to", name)
+		default:
+			return nil, fmt.Errorf("JSON-RPC namespace %q is recognized but not implemented yet", name)
+		}
+	}
+
+	return apis, nil
+}
+
+func clientVersion() string {
+	return "minievm/jsonrpc"
+}